@@ -0,0 +1,46 @@
+// Package api holds small response helpers shared by the HTTP handlers so
+// they all render success and error bodies the same way.
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	codederrors "github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// OKResponse writes data as a 200 JSON response.
+func OKResponse(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+}
+
+// ErrorResponse writes a plain {"error": message} JSON body with the given
+// status. Prefer WriteError for domain errors that carry a stable code.
+func ErrorResponse(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
+
+type errorBody struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// WriteError renders err as the canonical {"error":{"code","message","details"}}
+// body. If err is a *codederrors.CodedError, its Reason/Message/Status are
+// used directly; any other error is treated as an opaque internal error.
+func WriteError(w http.ResponseWriter, err error) {
+	coded, ok := err.(*codederrors.CodedError)
+	if !ok {
+		coded = codederrors.Internal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(coded.Status)
+	json.NewEncoder(w).Encode(map[string]errorBody{
+		"error": {Code: coded.Reason, Message: coded.Message},
+	})
+}
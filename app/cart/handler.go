@@ -0,0 +1,165 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/mytheresa/go-hiring-challenge/app/api"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// CartProvider is the persistence surface CartHandler needs; *models.CartRepository
+// satisfies it.
+type CartProvider interface {
+	AddItem(ctx context.Context, cartID uuid.UUID, productCode string, variantSKU *string, quantity int) (*models.CartItem, error)
+	UpdateQuantity(ctx context.Context, cartID uuid.UUID, lineKey string, quantity int) error
+	RemoveItem(ctx context.Context, cartID uuid.UUID, lineKey string) error
+	GetCart(ctx context.Context, cartID uuid.UUID) ([]models.CartItem, error)
+}
+
+type LineResponse struct {
+	ProductCode string          `json:"product_code"`
+	VariantSKU  *string         `json:"variant_sku,omitempty"`
+	Quantity    int             `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+	Subtotal    decimal.Decimal `json:"subtotal"`
+}
+
+type CartResponse struct {
+	CartID string          `json:"cart_id"`
+	Items  []LineResponse  `json:"items"`
+	Total  decimal.Decimal `json:"total"`
+}
+
+type CartHandler struct {
+	repo CartProvider
+}
+
+func NewCartHandler(r CartProvider) *CartHandler {
+	return &CartHandler{repo: r}
+}
+
+func toLineResponse(item *models.CartItem) LineResponse {
+	return LineResponse{
+		ProductCode: item.ProductCode,
+		VariantSKU:  item.VariantSKU,
+		Quantity:    item.Quantity,
+		UnitPrice:   item.UnitPrice,
+		Subtotal:    item.UnitPrice.Mul(decimal.NewFromInt(int64(item.Quantity))),
+	}
+}
+
+func parseCartID(r *http.Request) (uuid.UUID, error) {
+	cartID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		return uuid.UUID{}, errors.Invalid("id", r.PathValue("id"))
+	}
+	return cartID, nil
+}
+
+// HandleAddItem handles POST /cart/{id}/items.
+func (h *CartHandler) HandleAddItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := parseCartID(r)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	var input struct {
+		ProductCode string  `json:"product_code"`
+		VariantSKU  *string `json:"variant_sku"`
+		Quantity    int     `json:"quantity"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		api.WriteError(w, errors.Invalid("body", "malformed JSON"))
+		return
+	}
+	if input.ProductCode == "" {
+		api.WriteError(w, errors.Invalid("product_code", "missing"))
+		return
+	}
+
+	item, err := h.repo.AddItem(r.Context(), cartID, input.ProductCode, input.VariantSKU, input.Quantity)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toLineResponse(item))
+}
+
+// HandleUpdateQuantity handles PATCH /cart/{id}/items/{sku}.
+func (h *CartHandler) HandleUpdateQuantity(w http.ResponseWriter, r *http.Request) {
+	cartID, err := parseCartID(r)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	var input struct {
+		Quantity int `json:"quantity"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		api.WriteError(w, errors.Invalid("body", "malformed JSON"))
+		return
+	}
+
+	if err := h.repo.UpdateQuantity(r.Context(), cartID, r.PathValue("sku"), input.Quantity); err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	api.OKResponse(w, map[string]string{"message": "cart updated"})
+}
+
+// HandleRemoveItem handles DELETE /cart/{id}/items/{sku}.
+func (h *CartHandler) HandleRemoveItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := parseCartID(r)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	if err := h.repo.RemoveItem(r.Context(), cartID, r.PathValue("sku")); err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetCart handles GET /cart/{id}, returning every line with its
+// subtotal alongside the cart's grand total.
+func (h *CartHandler) HandleGetCart(w http.ResponseWriter, r *http.Request) {
+	cartID, err := parseCartID(r)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	items, err := h.repo.GetCart(r.Context(), cartID)
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
+
+	response := CartResponse{
+		CartID: cartID.String(),
+		Items:  make([]LineResponse, 0, len(items)),
+		Total:  decimal.Zero,
+	}
+	for _, item := range items {
+		line := toLineResponse(&item)
+		response.Items = append(response.Items, line)
+		response.Total = response.Total.Add(line.Subtotal)
+	}
+
+	api.OKResponse(w, response)
+}
@@ -0,0 +1,252 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// --- Mock Repository ---
+
+type MockCartRepo struct {
+	Items     []models.CartItem
+	AddErr    error
+	UpdateErr error
+	RemoveErr error
+	GetErr    error
+
+	LastAddedCode string
+	LastAddedSKU  *string
+	LastAddedQty  int
+	LastLineKey   string
+	LastQty       int
+}
+
+type errorEnvelope struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (m *MockCartRepo) AddItem(ctx context.Context, cartID uuid.UUID, productCode string, variantSKU *string, quantity int) (*models.CartItem, error) {
+	m.LastAddedCode = productCode
+	m.LastAddedSKU = variantSKU
+	m.LastAddedQty = quantity
+	if m.AddErr != nil {
+		return nil, m.AddErr
+	}
+	return &models.CartItem{
+		CartID:      cartID,
+		ProductCode: productCode,
+		VariantSKU:  variantSKU,
+		Quantity:    quantity,
+		UnitPrice:   decimal.NewFromFloat(10),
+	}, nil
+}
+
+func (m *MockCartRepo) UpdateQuantity(ctx context.Context, cartID uuid.UUID, lineKey string, quantity int) error {
+	m.LastLineKey = lineKey
+	m.LastQty = quantity
+	return m.UpdateErr
+}
+
+func (m *MockCartRepo) RemoveItem(ctx context.Context, cartID uuid.UUID, lineKey string) error {
+	m.LastLineKey = lineKey
+	return m.RemoveErr
+}
+
+func (m *MockCartRepo) GetCart(ctx context.Context, cartID uuid.UUID) ([]models.CartItem, error) {
+	if m.GetErr != nil {
+		return nil, m.GetErr
+	}
+	return m.Items, nil
+}
+
+// --- Tests: POST /cart/{id}/items ---
+
+func TestHandleAddItem(t *testing.T) {
+	cartID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &MockCartRepo{}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("POST", "/cart/"+cartID.String()+"/items", strings.NewReader(`{"product_code":"PROD001","quantity":2}`))
+		req.SetPathValue("id", cartID.String())
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddItem(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, "PROD001", repo.LastAddedCode)
+		assert.Equal(t, 2, repo.LastAddedQty)
+
+		var resp LineResponse
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.Equal(t, "PROD001", resp.ProductCode)
+		assert.Equal(t, 2, resp.Quantity)
+	})
+
+	t.Run("Missing product code", func(t *testing.T) {
+		repo := &MockCartRepo{}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("POST", "/cart/"+cartID.String()+"/items", strings.NewReader(`{"quantity":2}`))
+		req.SetPathValue("id", cartID.String())
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddItem(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		var errResp errorEnvelope
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+		assert.Equal(t, "INVALID_PRODUCT_CODE", errResp.Error.Code)
+	})
+
+	t.Run("Invalid cart id", func(t *testing.T) {
+		repo := &MockCartRepo{}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("POST", "/cart/not-a-uuid/items", strings.NewReader(`{"product_code":"PROD001","quantity":1}`))
+		req.SetPathValue("id", "not-a-uuid")
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddItem(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := &MockCartRepo{AddErr: errors.Invalid("quantity", 0)}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("POST", "/cart/"+cartID.String()+"/items", strings.NewReader(`{"product_code":"PROD001","quantity":0}`))
+		req.SetPathValue("id", cartID.String())
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddItem(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// --- Tests: PATCH /cart/{id}/items/{sku} ---
+
+func TestHandleUpdateQuantity(t *testing.T) {
+	cartID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &MockCartRepo{}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("PATCH", "/cart/"+cartID.String()+"/items/SKU001", strings.NewReader(`{"quantity":5}`))
+		req.SetPathValue("id", cartID.String())
+		req.SetPathValue("sku", "SKU001")
+		rec := httptest.NewRecorder()
+
+		handler.HandleUpdateQuantity(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "SKU001", repo.LastLineKey)
+		assert.Equal(t, 5, repo.LastQty)
+	})
+
+	t.Run("Unknown line", func(t *testing.T) {
+		repo := &MockCartRepo{UpdateErr: models.ErrCartItemNotFound}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("PATCH", "/cart/"+cartID.String()+"/items/NOPE", strings.NewReader(`{"quantity":1}`))
+		req.SetPathValue("id", cartID.String())
+		req.SetPathValue("sku", "NOPE")
+		rec := httptest.NewRecorder()
+
+		handler.HandleUpdateQuantity(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		var errResp errorEnvelope
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+		assert.Equal(t, "CART_ITEM_NOT_FOUND", errResp.Error.Code)
+	})
+}
+
+// --- Tests: DELETE /cart/{id}/items/{sku} ---
+
+func TestHandleRemoveItem(t *testing.T) {
+	cartID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &MockCartRepo{}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("DELETE", "/cart/"+cartID.String()+"/items/SKU001", nil)
+		req.SetPathValue("id", cartID.String())
+		req.SetPathValue("sku", "SKU001")
+		rec := httptest.NewRecorder()
+
+		handler.HandleRemoveItem(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "SKU001", repo.LastLineKey)
+	})
+
+	t.Run("Unknown line", func(t *testing.T) {
+		repo := &MockCartRepo{RemoveErr: models.ErrCartItemNotFound}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("DELETE", "/cart/"+cartID.String()+"/items/NOPE", nil)
+		req.SetPathValue("id", cartID.String())
+		req.SetPathValue("sku", "NOPE")
+		rec := httptest.NewRecorder()
+
+		handler.HandleRemoveItem(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// --- Tests: GET /cart/{id} ---
+
+func TestHandleGetCart(t *testing.T) {
+	cartID := uuid.New()
+	sku := "SKU001"
+
+	t.Run("Totals across lines", func(t *testing.T) {
+		repo := &MockCartRepo{
+			Items: []models.CartItem{
+				{CartID: cartID, ProductCode: "PROD001", VariantSKU: &sku, Quantity: 2, UnitPrice: decimal.NewFromFloat(15.5)},
+				{CartID: cartID, ProductCode: "PROD002", Quantity: 1, UnitPrice: decimal.NewFromFloat(30)},
+			},
+		}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("GET", "/cart/"+cartID.String(), nil)
+		req.SetPathValue("id", cartID.String())
+		rec := httptest.NewRecorder()
+
+		handler.HandleGetCart(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp CartResponse
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.Len(t, resp.Items, 2)
+		assert.True(t, resp.Total.Equal(decimal.NewFromFloat(61)))
+	})
+
+	t.Run("Empty cart", func(t *testing.T) {
+		repo := &MockCartRepo{Items: []models.CartItem{}}
+		handler := NewCartHandler(repo)
+		req := httptest.NewRequest("GET", "/cart/"+cartID.String(), nil)
+		req.SetPathValue("id", cartID.String())
+		rec := httptest.NewRecorder()
+
+		handler.HandleGetCart(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp CartResponse
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.Len(t, resp.Items, 0)
+		assert.True(t, resp.Total.Equal(decimal.Zero))
+	})
+}
@@ -0,0 +1,75 @@
+package catalog
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+)
+
+// errInvalidCursor is returned when a cursor cannot be decoded or no longer
+// matches the filters it was issued for.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// cursorPayload is the opaque state encoded into a cursor string. It pins the
+// last seen product so the next page can resume with a keyset query, plus a
+// hash of the filters that were active when it was issued so a client can't
+// reuse a cursor against a different query.
+type cursorPayload struct {
+	LastID     uint   `json:"id"`
+	FilterHash string `json:"fh"`
+}
+
+// filterHash fingerprints the filters relevant to cursor validity so a cursor
+// minted under one filter set is rejected if replayed against another.
+// Sort isn't included: GetProductsAfterCursor ignores it, so it can't affect
+// which rows a cursor resumes from.
+func filterHash(filters models.ProductFilters) string {
+	fields := []string{
+		strings.Join(filters.CategoryCodes, ","),
+		fmt.Sprintf("%v", filters.IncludeDescendants),
+		floatPtrString(filters.PriceLessThan),
+		floatPtrString(filters.PriceLessOrEqual),
+		floatPtrString(filters.PriceGreaterThan),
+		floatPtrString(filters.PriceGreaterOrEqual),
+		filters.Query,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "|")))
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+func floatPtrString(f *float64) string {
+	if f == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%v", *f)
+}
+
+func encodeCursor(lastID uint, filters models.ProductFilters) string {
+	payload := cursorPayload{LastID: lastID, FilterHash: filterHash(filters)}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		// payload is a fixed, always-marshalable struct.
+		panic(err)
+	}
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(raw string, filters models.ProductFilters) (cursorPayload, error) {
+	b, err := base64.URLEncoding.DecodeString(raw)
+	if err != nil {
+		return cursorPayload{}, errInvalidCursor
+	}
+	var payload cursorPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return cursorPayload{}, errInvalidCursor
+	}
+	if payload.FilterHash != filterHash(filters) {
+		return cursorPayload{}, errInvalidCursor
+	}
+	return payload, nil
+}
@@ -0,0 +1,104 @@
+package catalog
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// parseFilters builds a models.ProductFilters from the catalog listing's
+// query params: category (repeatable or comma-separated, matched with IN),
+// include_descendants (expands category to every transitive subcategory),
+// price_lt/price_lte/price_gt/price_gte (each bound's strict and inclusive
+// variant are mutually exclusive), a free-text q matched against a
+// product's name/description, and sort (comma-separated keys, a leading
+// "-" for descending, e.g. "sort=price,-code").
+func parseFilters(q url.Values) (models.ProductFilters, error) {
+	filters := models.ProductFilters{
+		CategoryCodes:      parseCategoryCodes(q["category"]),
+		IncludeDescendants: q.Get("include_descendants") == "true",
+		Query:              q.Get("q"),
+	}
+
+	var err error
+	if filters.PriceLessThan, filters.PriceLessOrEqual, err = parsePriceBound(q, "price_lt", "price_lte"); err != nil {
+		return models.ProductFilters{}, err
+	}
+	if filters.PriceGreaterThan, filters.PriceGreaterOrEqual, err = parsePriceBound(q, "price_gt", "price_gte"); err != nil {
+		return models.ProductFilters{}, err
+	}
+
+	if sortStr := q.Get("sort"); sortStr != "" {
+		if filters.Sort, err = parseSort(sortStr); err != nil {
+			return models.ProductFilters{}, err
+		}
+	}
+
+	return filters, nil
+}
+
+// parseCategoryCodes flattens repeated `category` params and comma-separated
+// values within each into a single list of codes.
+func parseCategoryCodes(raw []string) []string {
+	var codes []string
+	for _, v := range raw {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				codes = append(codes, part)
+			}
+		}
+	}
+	return codes
+}
+
+// parsePriceBound parses the strict and inclusive variants of one price
+// bound (e.g. price_lt/price_lte). Providing both is rejected: it's
+// ambiguous which should take precedence.
+func parsePriceBound(q url.Values, strictParam, inclusiveParam string) (strict, inclusive *float64, err error) {
+	if raw := q.Get(strictParam); raw != "" {
+		if v, perr := strconv.ParseFloat(raw, 64); perr == nil {
+			strict = &v
+		}
+	}
+	if raw := q.Get(inclusiveParam); raw != "" {
+		if v, perr := strconv.ParseFloat(raw, 64); perr == nil {
+			inclusive = &v
+		}
+	}
+	if strict != nil && inclusive != nil {
+		return nil, nil, errors.Invalid(strictParam+"/"+inclusiveParam, "both provided")
+	}
+	return strict, inclusive, nil
+}
+
+// parseSort parses a comma-separated list of sort keys, e.g. "price,-code".
+func parseSort(raw string) ([]models.SortKey, error) {
+	parts := strings.Split(raw, ",")
+	keys := make([]models.SortKey, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key := models.SortKey{}
+		if strings.HasPrefix(part, "-") {
+			key.Descending = true
+			part = part[1:]
+		}
+
+		switch part {
+		case string(models.SortByPrice):
+			key.Field = models.SortByPrice
+		case string(models.SortByCode):
+			key.Field = models.SortByCode
+		default:
+			return nil, errors.Invalid("sort", part)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -1,16 +1,28 @@
 package catalog
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
 
+	"github.com/mytheresa/go-hiring-challenge/app/api"
 	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
 )
 
 type Response struct {
-	Total    int       `json:"total"`
-	Products []Product `json:"products"`
+	Total    int         `json:"total"`
+	Products []Product   `json:"products"`
+	Cursor   *CursorInfo `json:"cursor,omitempty"`
+}
+
+// CursorInfo describes the client's position in a cursor-paginated listing.
+// Self echoes the cursor that produced this page; Next is the opaque cursor
+// for the following page, omitted once the last page has been reached.
+type CursorInfo struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
 }
 
 type Category struct {
@@ -31,9 +43,10 @@ type Variant struct {
 }
 
 type ProductProvider interface {
-	GetAllProducts() ([]models.Product, error)
-	GetFilteredProducts(offset, limit int, filters models.ProductFilters) ([]models.Product, int64, error)
-	GetByCode(code string) (*models.Product, error)
+	GetAllProducts(ctx context.Context) ([]models.Product, error)
+	GetFilteredProducts(ctx context.Context, offset, limit int, filters models.ProductFilters) ([]models.Product, int64, error)
+	GetProductsAfterCursor(ctx context.Context, lastID uint, limit int, filters models.ProductFilters) ([]models.Product, bool, error)
+	GetByCode(ctx context.Context, code string) (*models.Product, error)
 }
 
 type CatalogHandler struct {
@@ -69,27 +82,88 @@ func (h *CatalogHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Parse filters
-	categoryCode := r.URL.Query().Get("category")
+	filters, err := parseFilters(r.URL.Query())
+	if err != nil {
+		api.WriteError(w, err)
+		return
+	}
 
-	var priceFilter *float64
-	if priceStr := r.URL.Query().Get("price_lt"); priceStr != "" {
-		if val, err := strconv.ParseFloat(priceStr, 64); err == nil {
-			priceFilter = &val
+	// Cursor mode is preferred over offset/limit for large or changing
+	// catalogs: it's a keyset query rather than an OFFSET scan, so pages stay
+	// consistent even as rows are inserted or deleted between requests. The
+	// presence of the `cursor` param (even empty, for the first page) opts
+	// into this mode; its absence keeps the legacy offset/limit behavior.
+	if r.URL.Query().Has("cursor") {
+		// The keyset query backing cursor pagination only orders by id, so a
+		// requested sort can't be honored; reject the combination instead of
+		// silently returning id-ordered rows a client asked to sort by price.
+		if len(filters.Sort) > 0 {
+			api.WriteError(w, errors.Invalid("sort", "sort is not supported together with cursor pagination"))
+			return
 		}
+		h.handleGetWithCursor(w, r.Context(), r.URL.Query().Get("cursor"), limit, filters)
+		return
 	}
 
-	filters := models.ProductFilters{
-		CategoryCode:  categoryCode,
-		PriceLessThan: priceFilter,
+	res, total, err := h.repo.GetFilteredProducts(r.Context(), offset, limit, filters)
+	if err != nil {
+		api.WriteError(w, err)
+		return
 	}
 
-	res, total, err := h.repo.GetFilteredProducts(offset, limit, filters)
-	if err != nil {
+	// Offset/limit responses carry a cursor too, with no input cursor to
+	// echo back as Self, so clients can migrate to cursor pagination without
+	// a breaking response-shape change.
+	cursor := &CursorInfo{}
+	if len(res) > 0 && offset+len(res) < int(total) {
+		cursor.Next = encodeCursor(res[len(res)-1].ID, filters)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	response := Response{
+		Total:    int(total),
+		Products: toProducts(res),
+		Cursor:   cursor,
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *CatalogHandler) handleGetWithCursor(w http.ResponseWriter, ctx context.Context, cursorStr string, limit int, filters models.ProductFilters) {
+	var lastID uint
+	if cursorStr != "" {
+		payload, err := decodeCursor(cursorStr, filters)
+		if err != nil {
+			api.WriteError(w, errors.Invalid("cursor", cursorStr))
+			return
+		}
+		lastID = payload.LastID
+	}
+
+	res, hasNext, err := h.repo.GetProductsAfterCursor(ctx, lastID, limit, filters)
+	if err != nil {
+		api.WriteError(w, err)
 		return
 	}
 
+	cursor := &CursorInfo{Self: cursorStr}
+	if hasNext && len(res) > 0 {
+		cursor.Next = encodeCursor(res[len(res)-1].ID, filters)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	response := Response{
+		Total:    len(res),
+		Products: toProducts(res),
+		Cursor:   cursor,
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func toProducts(res []models.Product) []Product {
 	products := make([]Product, len(res))
 	for i, p := range res {
 		products[i] = Product{
@@ -101,23 +175,15 @@ func (h *CatalogHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
 			},
 		}
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	response := Response{
-		Total:    int(total),
-		Products: products,
-	}
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
+	return products
 }
 
 func (h *CatalogHandler) HandleGetProduct(w http.ResponseWriter, r *http.Request) {
 	code := r.PathValue("code")
 
-	product, err := h.repo.GetByCode(code)
+	product, err := h.repo.GetByCode(r.Context(), code)
 	if err != nil {
-		http.Error(w, "Product not found", http.StatusNotFound)
+		api.WriteError(w, err)
 		return
 	}
 
@@ -1,10 +1,12 @@
 package catalog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/mytheresa/go-hiring-challenge/models"
@@ -12,6 +14,13 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+type errorEnvelope struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
 // --- Mock Repo ---
 
 type MockProductRepo struct {
@@ -23,13 +32,14 @@ type MockProductRepo struct {
 	lastCalledLimit   int
 	lastCalledFilters models.ProductFilters
 	lastCalledCode    string
+	lastCalledLastID  uint
 }
 
-func (m *MockProductRepo) GetAllProducts() ([]models.Product, error) {
+func (m *MockProductRepo) GetAllProducts(ctx context.Context) ([]models.Product, error) {
 	return nil, nil
 }
 
-func (m *MockProductRepo) GetFilteredProducts(offset, limit int, filters models.ProductFilters) ([]models.Product, int64, error) {
+func (m *MockProductRepo) GetFilteredProducts(ctx context.Context, offset, limit int, filters models.ProductFilters) ([]models.Product, int64, error) {
 	m.lastCalledOffset = offset
 	m.lastCalledLimit = limit
 	m.lastCalledFilters = filters
@@ -41,17 +51,7 @@ func (m *MockProductRepo) GetFilteredProducts(offset, limit int, filters models.
 	// Simulate filtering
 	var filteredProducts []models.Product
 	for _, p := range m.SourceProducts {
-		match := true
-		// Category filter
-		if filters.CategoryCode != "" && p.Category.Code != filters.CategoryCode {
-			match = false
-		}
-		// Price filter
-		if filters.PriceLessThan != nil && p.Price.InexactFloat64() >= *filters.PriceLessThan {
-			match = false
-		}
-
-		if match {
+		if matchesFilters(p, filters) {
 			filteredProducts = append(filteredProducts, p)
 		}
 	}
@@ -71,7 +71,34 @@ func (m *MockProductRepo) GetFilteredProducts(offset, limit int, filters models.
 	return filteredProducts[start:end], total, nil
 }
 
-func (m *MockProductRepo) GetByCode(code string) (*models.Product, error) {
+func (m *MockProductRepo) GetProductsAfterCursor(ctx context.Context, lastID uint, limit int, filters models.ProductFilters) ([]models.Product, bool, error) {
+	m.lastCalledLastID = lastID
+	m.lastCalledLimit = limit
+	m.lastCalledFilters = filters
+
+	if m.Err != nil {
+		return nil, false, m.Err
+	}
+
+	var filtered []models.Product
+	for _, p := range m.SourceProducts {
+		if p.ID <= lastID {
+			continue
+		}
+		if matchesFilters(p, filters) {
+			filtered = append(filtered, p)
+		}
+	}
+
+	hasNext := len(filtered) > limit
+	if hasNext {
+		filtered = filtered[:limit]
+	}
+
+	return filtered, hasNext, nil
+}
+
+func (m *MockProductRepo) GetByCode(ctx context.Context, code string) (*models.Product, error) {
 	m.lastCalledCode = code
 
 	if m.Err != nil {
@@ -89,6 +116,61 @@ func (m *MockProductRepo) GetByCode(code string) (*models.Product, error) {
 
 // --- Helpers ---
 
+// matchesFilters mirrors the WHERE clauses ProductsRepository builds, so
+// MockProductRepo can simulate filtering without a real database.
+func matchesFilters(p models.Product, filters models.ProductFilters) bool {
+	if len(filters.CategoryCodes) > 0 {
+		if filters.IncludeDescendants {
+			if !matchesAnyCategoryOrDescendant(p.Category, filters.CategoryCodes) {
+				return false
+			}
+		} else if !contains(filters.CategoryCodes, p.Category.Code) {
+			return false
+		}
+	}
+	price := p.Price.InexactFloat64()
+	if filters.PriceLessThan != nil && price >= *filters.PriceLessThan {
+		return false
+	}
+	if filters.PriceLessOrEqual != nil && price > *filters.PriceLessOrEqual {
+		return false
+	}
+	if filters.PriceGreaterThan != nil && price <= *filters.PriceGreaterThan {
+		return false
+	}
+	if filters.PriceGreaterOrEqual != nil && price < *filters.PriceGreaterOrEqual {
+		return false
+	}
+	if filters.Query != "" &&
+		!strings.Contains(strings.ToLower(p.Name), strings.ToLower(filters.Query)) &&
+		!strings.Contains(strings.ToLower(p.Description), strings.ToLower(filters.Query)) {
+		return false
+	}
+	return true
+}
+
+// matchesAnyCategoryOrDescendant reports whether cat is, or is nested
+// under, one of codes. It approximates the repo's path-prefix match:
+// fixtures here build root categories with Path "/code/", so "/"+code+"/"
+// is the expected prefix for a top-level filter category.
+func matchesAnyCategoryOrDescendant(cat models.Category, codes []string) bool {
+	for _, code := range codes {
+		if cat.Code == code || strings.HasPrefix(cat.Path, "/"+code+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
+
 func newTestProduct(code, categoryCode, categoryName string, price float64) models.Product {
 	return models.Product{
 		Code:  code,
@@ -100,6 +182,19 @@ func newTestProduct(code, categoryCode, categoryName string, price float64) mode
 	}
 }
 
+func newTestProductWithID(id uint, code, categoryCode, categoryName string, price float64) models.Product {
+	p := newTestProduct(code, categoryCode, categoryName, price)
+	p.ID = id
+	return p
+}
+
+func newTestProductWithDetails(code, categoryCode, categoryName string, price float64, name, description string) models.Product {
+	p := newTestProduct(code, categoryCode, categoryName, price)
+	p.Name = name
+	p.Description = description
+	return p
+}
+
 // --- Tests ---
 
 func TestHandleGet(t *testing.T) {
@@ -138,7 +233,7 @@ func TestHandleGet(t *testing.T) {
 			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
 				assert.Equal(t, 0, repo.lastCalledOffset, "Expected default offset 0")
 				assert.Equal(t, 10, repo.lastCalledLimit, "Expected default limit 10")
-				assert.Empty(t, repo.lastCalledFilters.CategoryCode)
+				assert.Empty(t, repo.lastCalledFilters.CategoryCodes)
 				assert.Nil(t, repo.lastCalledFilters.PriceLessThan)
 			},
 		},
@@ -204,7 +299,7 @@ func TestHandleGet(t *testing.T) {
 				assert.Equal(t, "PROD004", resp.Products[1].Code)
 			},
 			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
-				assert.Equal(t, "clothing", repo.lastCalledFilters.CategoryCode)
+				assert.Equal(t, []string{"clothing"}, repo.lastCalledFilters.CategoryCodes)
 				assert.Nil(t, repo.lastCalledFilters.PriceLessThan)
 			},
 		},
@@ -225,7 +320,7 @@ func TestHandleGet(t *testing.T) {
 			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
 				assert.NotNil(t, repo.lastCalledFilters.PriceLessThan)
 				assert.Equal(t, 20.0, *repo.lastCalledFilters.PriceLessThan)
-				assert.Empty(t, repo.lastCalledFilters.CategoryCode)
+				assert.Empty(t, repo.lastCalledFilters.CategoryCodes)
 			},
 		},
 		{
@@ -244,7 +339,7 @@ func TestHandleGet(t *testing.T) {
 				assert.Equal(t, "PROD002", resp.Products[0].Code)
 			},
 			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
-				assert.Equal(t, "clothing", repo.lastCalledFilters.CategoryCode)
+				assert.Equal(t, []string{"clothing"}, repo.lastCalledFilters.CategoryCodes)
 				assert.NotNil(t, repo.lastCalledFilters.PriceLessThan)
 				assert.Equal(t, 30.0, *repo.lastCalledFilters.PriceLessThan)
 			},
@@ -264,7 +359,7 @@ func TestHandleGet(t *testing.T) {
 				assert.Len(t, resp.Products, 0)
 			},
 			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
-				assert.Equal(t, "nonexistent", repo.lastCalledFilters.CategoryCode)
+				assert.Equal(t, []string{"nonexistent"}, repo.lastCalledFilters.CategoryCodes)
 			},
 		},
 		{
@@ -275,10 +370,10 @@ func TestHandleGet(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "failed to get products", errResp["error"])
+				assert.Equal(t, "INTERNAL", errResp.Error.Code)
 			},
 		},
 		{
@@ -301,6 +396,178 @@ func TestHandleGet(t *testing.T) {
 				assert.Nil(t, repo.lastCalledFilters.PriceLessThan, "Expected nil price filter for invalid value")
 			},
 		},
+		{
+			name: "Multi-valued category via repeated param",
+			url:  "/catalog?category=clothing&category=shoes",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.Equal(t, []string{"clothing", "shoes"}, repo.lastCalledFilters.CategoryCodes)
+			},
+		},
+		{
+			name: "Multi-valued category via comma-separated param",
+			url:  "/catalog?category=clothing,shoes",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.Equal(t, []string{"clothing", "shoes"}, repo.lastCalledFilters.CategoryCodes)
+			},
+		},
+		{
+			name: "Filter by price_gt",
+			url:  "/catalog?price_gt=20",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.NotNil(t, repo.lastCalledFilters.PriceGreaterThan)
+				assert.Equal(t, 20.0, *repo.lastCalledFilters.PriceGreaterThan)
+			},
+		},
+		{
+			name: "Filter by price_gte",
+			url:  "/catalog?price_gte=24.99",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp Response
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, 2, resp.Total)
+			},
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.NotNil(t, repo.lastCalledFilters.PriceGreaterOrEqual)
+				assert.Equal(t, 24.99, *repo.lastCalledFilters.PriceGreaterOrEqual)
+			},
+		},
+		{
+			name: "Filter by price_lte",
+			url:  "/catalog?price_lte=19.99",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp Response
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, 2, resp.Total)
+			},
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.NotNil(t, repo.lastCalledFilters.PriceLessOrEqual)
+				assert.Equal(t, 19.99, *repo.lastCalledFilters.PriceLessOrEqual)
+			},
+		},
+		{
+			name: "price_lt and price_lte both provided is rejected",
+			url:  "/catalog?price_lt=20&price_lte=20",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var errResp errorEnvelope
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+				assert.Equal(t, "INVALID_PRICE_LT/PRICE_LTE", errResp.Error.Code)
+			},
+		},
+		{
+			name: "price_gt and price_gte both provided is rejected",
+			url:  "/catalog?price_gt=20&price_gte=20",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Free-text q matches name and description",
+			url:  "/catalog?q=running",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: []models.Product{
+					newTestProductWithDetails("PROD005", "shoes", "Shoes", 50, "Running shoe", ""),
+					newTestProductWithDetails("PROD006", "shoes", "Shoes", 60, "Walking shoe", "Good for running errands"),
+					newTestProductWithDetails("PROD007", "shoes", "Shoes", 70, "Boot", ""),
+				}}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp Response
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, 2, resp.Total)
+			},
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.Equal(t, "running", repo.lastCalledFilters.Query)
+			},
+		},
+		{
+			name: "Sort by multiple fields",
+			url:  "/catalog?sort=price,-code",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.Equal(t, []models.SortKey{
+					{Field: models.SortByPrice},
+					{Field: models.SortByCode, Descending: true},
+				}, repo.lastCalledFilters.Sort)
+			},
+		},
+		{
+			name: "Category filter without include_descendants excludes subcategory products",
+			url:  "/catalog?category=clothing",
+			mockRepoSetup: func() *MockProductRepo {
+				clothing := newTestProduct("PROD002", "clothing", "Clothing", 24.99)
+				shirts := newTestProduct("PROD010", "shirts", "Shirts", 29.99)
+				shirts.Category.Path = "/clothing/shirts/"
+				return &MockProductRepo{SourceProducts: []models.Product{clothing, shirts}}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp Response
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, 1, resp.Total)
+				assert.Equal(t, "PROD002", resp.Products[0].Code)
+			},
+		},
+		{
+			name: "Category filter with include_descendants includes subcategory products",
+			url:  "/catalog?category=clothing&include_descendants=true",
+			mockRepoSetup: func() *MockProductRepo {
+				clothing := newTestProduct("PROD002", "clothing", "Clothing", 24.99)
+				shirts := newTestProduct("PROD010", "shirts", "Shirts", 29.99)
+				shirts.Category.Path = "/clothing/shirts/"
+				return &MockProductRepo{SourceProducts: []models.Product{clothing, shirts}}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp Response
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+				assert.Equal(t, 2, resp.Total)
+			},
+			checkRepoCalls: func(t *testing.T, repo *MockProductRepo) {
+				assert.True(t, repo.lastCalledFilters.IncludeDescendants)
+			},
+		},
+		{
+			name: "Unrecognized sort field is rejected",
+			url:  "/catalog?sort=popularity",
+			mockRepoSetup: func() *MockProductRepo {
+				return &MockProductRepo{SourceProducts: allMockProducts}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var errResp errorEnvelope
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&errResp))
+				assert.Equal(t, "INVALID_SORT", errResp.Error.Code)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -327,3 +594,113 @@ func TestHandleGet(t *testing.T) {
 		})
 	}
 }
+
+// --- Cursor pagination tests ---
+
+func TestHandleGetCursorPagination(t *testing.T) {
+	cursorProducts := []models.Product{
+		newTestProductWithID(1, "PROD001", "shoes", "Shoes", 19.99),
+		newTestProductWithID(2, "PROD002", "clothing", "Clothing", 24.99),
+		newTestProductWithID(3, "PROD003", "accessories", "Accessories", 10.00),
+	}
+
+	t.Run("first page with an empty cursor param returns a non-empty next cursor", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		req := httptest.NewRequest("GET", "/catalog?cursor=&limit=2", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp Response
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.Len(t, resp.Products, 2)
+		assert.Equal(t, "PROD001", resp.Products[0].Code)
+		assert.NotNil(t, resp.Cursor)
+		assert.NotEmpty(t, resp.Cursor.Next)
+		assert.Equal(t, uint(0), mockRepo.lastCalledLastID)
+
+		t.Run("following next yields the next batch", func(t *testing.T) {
+			mockRepo2 := &MockProductRepo{SourceProducts: cursorProducts}
+			handler2 := NewCatalogHandler(mockRepo2)
+			req2 := httptest.NewRequest("GET", "/catalog?cursor="+resp.Cursor.Next+"&limit=2", nil)
+			rec2 := httptest.NewRecorder()
+
+			handler2.HandleGet(rec2, req2)
+
+			assert.Equal(t, http.StatusOK, rec2.Code)
+			var resp2 Response
+			assert.NoError(t, json.NewDecoder(rec2.Body).Decode(&resp2))
+			assert.Len(t, resp2.Products, 1)
+			assert.Equal(t, "PROD003", resp2.Products[0].Code)
+			assert.Empty(t, resp2.Cursor.Next, "last page should not carry a next cursor")
+		})
+	})
+
+	t.Run("omitting the cursor param stays on the offset/limit path but still carries a cursor for migration", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		req := httptest.NewRequest("GET", "/catalog?limit=2", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp Response
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.NotNil(t, resp.Cursor, "offset/limit responses carry a cursor object so clients can migrate")
+		assert.Empty(t, resp.Cursor.Self, "no input cursor produced this page")
+		assert.NotEmpty(t, resp.Cursor.Next, "more rows remain past this page")
+	})
+
+	t.Run("offset/limit last page carries no next cursor", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		req := httptest.NewRequest("GET", "/catalog?offset=2&limit=2", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp Response
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+		assert.NotNil(t, resp.Cursor)
+		assert.Empty(t, resp.Cursor.Next, "last page should not carry a next cursor")
+	})
+
+	t.Run("tampered cursor returns 400", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		req := httptest.NewRequest("GET", "/catalog?cursor=not-a-real-cursor", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("cursor issued under different filters is rejected", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		issued := encodeCursor(1, models.ProductFilters{CategoryCodes: []string{"shoes"}})
+
+		req := httptest.NewRequest("GET", "/catalog?cursor="+issued+"&category=clothing", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("sort combined with cursor is rejected rather than silently ignored", func(t *testing.T) {
+		mockRepo := &MockProductRepo{SourceProducts: cursorProducts}
+		handler := NewCatalogHandler(mockRepo)
+		req := httptest.NewRequest("GET", "/catalog?cursor=&sort=price", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandleGet(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
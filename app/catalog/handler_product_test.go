@@ -88,10 +88,10 @@ func TestHandleGetProduct(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusNotFound,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Product not found", errResp["error"])
+				assert.Equal(t, "PRODUCT_NOT_FOUND", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockProductRepo) {
 				assert.Equal(t, "NONEXISTENT", repo.lastCalledCode)
@@ -105,10 +105,10 @@ func TestHandleGetProduct(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Failed to retrieve product", errResp["error"])
+				assert.Equal(t, "INTERNAL", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockProductRepo) {
 				assert.Equal(t, "PROD-ERR", repo.lastCalledCode)
@@ -152,10 +152,10 @@ func TestHandleGetProduct(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusNotFound,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Product not found", errResp["error"])
+				assert.Equal(t, "PRODUCT_NOT_FOUND", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockProductRepo) {
 				assert.Equal(t, "", repo.lastCalledCode)
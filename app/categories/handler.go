@@ -1,11 +1,13 @@
 package categories
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	"github.com/mytheresa/go-hiring-challenge/app/api"
 	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
 )
 
 type CategoryResponse struct {
@@ -13,9 +15,19 @@ type CategoryResponse struct {
 	Name string `json:"name"`
 }
 
+// CategoryTreeResponse is a category nested with its descendants, as
+// returned by HandleGetTree.
+type CategoryTreeResponse struct {
+	Code     string                 `json:"code"`
+	Name     string                 `json:"name"`
+	Children []CategoryTreeResponse `json:"children,omitempty"`
+}
+
 type CategoryProvider interface {
-	GetAllCategories() ([]models.Category, error)
-	CreateCategory(category *models.Category) error
+	GetAllCategories(ctx context.Context) ([]models.Category, error)
+	GetCategoryTree(ctx context.Context) ([]models.Category, error)
+	GetCategoryByCode(ctx context.Context, code string) (*models.Category, error)
+	CreateCategory(ctx context.Context, category *models.Category) error
 }
 
 type CategoryHandler struct {
@@ -27,9 +39,9 @@ func NewCategoryHandler(r CategoryProvider) *CategoryHandler {
 }
 
 func (h *CategoryHandler) HandleGetAll(w http.ResponseWriter, r *http.Request) {
-	categories, err := h.repo.GetAllCategories()
+	categories, err := h.repo.GetAllCategories(r.Context())
 	if err != nil {
-		api.ErrorResponse(w, http.StatusInternalServerError, "failed to fetch categories")
+		api.WriteError(w, errors.Internal(err))
 		return
 	}
 
@@ -44,19 +56,44 @@ func (h *CategoryHandler) HandleGetAll(w http.ResponseWriter, r *http.Request) {
 	api.OKResponse(w, response)
 }
 
+// HandleGetTree returns every category with its children nested under it,
+// rooted at the categories with no parent.
+func (h *CategoryHandler) HandleGetTree(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.repo.GetCategoryTree(r.Context())
+	if err != nil {
+		api.WriteError(w, errors.Internal(err))
+		return
+	}
+
+	api.OKResponse(w, toCategoryTreeResponse(categories))
+}
+
+func toCategoryTreeResponse(categories []models.Category) []CategoryTreeResponse {
+	response := make([]CategoryTreeResponse, len(categories))
+	for i, c := range categories {
+		response[i] = CategoryTreeResponse{
+			Code:     c.Code,
+			Name:     c.Name,
+			Children: toCategoryTreeResponse(c.Children),
+		}
+	}
+	return response
+}
+
 func (h *CategoryHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	var input struct {
-		Code string `json:"code"`
-		Name string `json:"name"`
+		Code       string `json:"code"`
+		Name       string `json:"name"`
+		ParentCode string `json:"parent_code"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		api.ErrorResponse(w, http.StatusBadRequest, "Invalid JSON body")
+		api.WriteError(w, errors.Invalid("body", "malformed JSON"))
 		return
 	}
 
 	if input.Code == "" || input.Name == "" {
-		api.ErrorResponse(w, http.StatusBadRequest, "Missing code or name")
+		api.WriteError(w, errors.Invalid("code_or_name", "missing"))
 		return
 	}
 
@@ -65,8 +102,17 @@ func (h *CategoryHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		Name: input.Name,
 	}
 
-	if err := h.repo.CreateCategory(category); err != nil {
-		api.ErrorResponse(w, http.StatusInternalServerError, "Failed to create category")
+	if input.ParentCode != "" {
+		parent, err := h.repo.GetCategoryByCode(r.Context(), input.ParentCode)
+		if err != nil {
+			api.WriteError(w, errors.Invalid("parent_code", input.ParentCode))
+			return
+		}
+		category.ParentID = &parent.ID
+	}
+
+	if err := h.repo.CreateCategory(r.Context(), category); err != nil {
+		api.WriteError(w, err)
 		return
 	}
 
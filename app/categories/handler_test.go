@@ -1,6 +1,7 @@
 package categories
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -16,19 +17,42 @@ import (
 
 type MockCategoryRepo struct {
 	Categories []models.Category
+	Tree       []models.Category
+	ByCode     map[string]models.Category
 	CreateErr  error
 	ListErr    error
 	LastSaved  *models.Category
 }
 
-func (m *MockCategoryRepo) GetAllCategories() ([]models.Category, error) {
+type errorEnvelope struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (m *MockCategoryRepo) GetAllCategories(ctx context.Context) ([]models.Category, error) {
 	if m.ListErr != nil {
 		return nil, m.ListErr
 	}
 	return m.Categories, nil
 }
 
-func (m *MockCategoryRepo) CreateCategory(cat *models.Category) error {
+func (m *MockCategoryRepo) GetCategoryTree(ctx context.Context) ([]models.Category, error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+	return m.Tree, nil
+}
+
+func (m *MockCategoryRepo) GetCategoryByCode(ctx context.Context, code string) (*models.Category, error) {
+	if c, ok := m.ByCode[code]; ok {
+		return &c, nil
+	}
+	return nil, models.ErrCategoryNotFound
+}
+
+func (m *MockCategoryRepo) CreateCategory(ctx context.Context, cat *models.Category) error {
 	m.LastSaved = cat
 	return m.CreateErr
 }
@@ -86,10 +110,10 @@ func TestHandleGetAll(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "failed to fetch categories", errResp["error"])
+				assert.Equal(t, "INTERNAL", errResp.Error.Code)
 			},
 		},
 	}
@@ -114,6 +138,77 @@ func TestHandleGetAll(t *testing.T) {
 	}
 }
 
+// --- Tests: GET /categories/tree ---
+
+func TestHandleGetTree(t *testing.T) {
+	testCases := []struct {
+		name               string
+		mockRepoSetup      func() *MockCategoryRepo
+		expectedStatusCode int
+		checkResponse      func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Success with nested categories",
+			mockRepoSetup: func() *MockCategoryRepo {
+				return &MockCategoryRepo{
+					Tree: []models.Category{
+						{
+							Code: "clothing",
+							Name: "Clothing",
+							Children: []models.Category{
+								{Code: "shirts", Name: "Shirts"},
+							},
+						},
+						{Code: "shoes", Name: "Shoes"},
+					},
+				}
+			},
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var resp []CategoryTreeResponse
+				err := json.NewDecoder(rec.Body).Decode(&resp)
+				assert.NoError(t, err)
+				assert.Len(t, resp, 2)
+				assert.Equal(t, "clothing", resp[0].Code)
+				assert.Len(t, resp[0].Children, 1)
+				assert.Equal(t, "shirts", resp[0].Children[0].Code)
+				assert.Empty(t, resp[1].Children)
+			},
+		},
+		{
+			name: "Repository error",
+			mockRepoSetup: func() *MockCategoryRepo {
+				return &MockCategoryRepo{
+					ListErr: errors.New("db down"),
+				}
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var errResp errorEnvelope
+				err := json.NewDecoder(rec.Body).Decode(&errResp)
+				assert.NoError(t, err)
+				assert.Equal(t, "INTERNAL", errResp.Error.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := tc.mockRepoSetup()
+			handler := NewCategoryHandler(mockRepo)
+			req := httptest.NewRequest("GET", "/categories/tree", nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleGetTree(rec, req)
+
+			assert.Equal(t, tc.expectedStatusCode, rec.Code)
+			if tc.checkResponse != nil {
+				tc.checkResponse(t, rec)
+			}
+		})
+	}
+}
+
 // --- Tests: POST /categories ---
 
 func TestHandleCreate(t *testing.T) {
@@ -152,10 +247,10 @@ func TestHandleCreate(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusBadRequest,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Invalid JSON body", errResp["error"])
+				assert.Equal(t, "INVALID_BODY", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockCategoryRepo) {
 				assert.Nil(t, repo.LastSaved, "CreateCategory should not be called with invalid JSON")
@@ -169,10 +264,10 @@ func TestHandleCreate(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusBadRequest,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Missing code or name", errResp["error"])
+				assert.Equal(t, "INVALID_CODE_OR_NAME", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockCategoryRepo) {
 				assert.Nil(t, repo.LastSaved, "CreateCategory should not be called with missing fields")
@@ -186,16 +281,51 @@ func TestHandleCreate(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
-				var errResp map[string]string
+				var errResp errorEnvelope
 				err := json.NewDecoder(rec.Body).Decode(&errResp)
 				assert.NoError(t, err)
-				assert.Equal(t, "Failed to create category", errResp["error"])
+				assert.Equal(t, "INTERNAL", errResp.Error.Code)
 			},
 			checkRepoCall: func(t *testing.T, repo *MockCategoryRepo) {
 				assert.NotNil(t, repo.LastSaved, "CreateCategory should have been called")
 				assert.Equal(t, "toys", repo.LastSaved.Code)
 			},
 		},
+		{
+			name:        "Success with parent_code resolves to ParentID",
+			requestBody: `{"code":"shirts","name":"Shirts","parent_code":"clothing"}`,
+			mockRepoSetup: func() *MockCategoryRepo {
+				parentID := uint(7)
+				return &MockCategoryRepo{
+					ByCode: map[string]models.Category{
+						"clothing": {ID: parentID, Code: "clothing", Name: "Clothing"},
+					},
+				}
+			},
+			expectedStatusCode: http.StatusCreated,
+			checkRepoCall: func(t *testing.T, repo *MockCategoryRepo) {
+				assert.NotNil(t, repo.LastSaved)
+				assert.NotNil(t, repo.LastSaved.ParentID)
+				assert.Equal(t, uint(7), *repo.LastSaved.ParentID)
+			},
+		},
+		{
+			name:        "Unknown parent_code",
+			requestBody: `{"code":"shirts","name":"Shirts","parent_code":"missing"}`,
+			mockRepoSetup: func() *MockCategoryRepo {
+				return &MockCategoryRepo{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var errResp errorEnvelope
+				err := json.NewDecoder(rec.Body).Decode(&errResp)
+				assert.NoError(t, err)
+				assert.Equal(t, "INVALID_PARENT_CODE", errResp.Error.Code)
+			},
+			checkRepoCall: func(t *testing.T, repo *MockCategoryRepo) {
+				assert.Nil(t, repo.LastSaved, "CreateCategory should not be called with an unknown parent_code")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	catalogpb "github.com/mytheresa/go-hiring-challenge/proto/catalog"
+)
+
+// catalogServer adapts catalog.ProductProvider to the generated gRPC
+// service interface, so HTTP and gRPC share one source of truth for product
+// reads.
+type catalogServer struct {
+	catalogpb.UnimplementedCatalogServiceServer
+	repo catalog.ProductProvider
+}
+
+func NewCatalogServer(repo catalog.ProductProvider) catalogpb.CatalogServiceServer {
+	return &catalogServer{repo: repo}
+}
+
+func (s *catalogServer) ListProducts(ctx context.Context, req *catalogpb.ListProductsRequest) (*catalogpb.ListProductsResponse, error) {
+	filters := models.ProductFilters{}
+	if cc := req.GetCategoryCode(); cc != "" {
+		filters.CategoryCodes = []string{cc}
+	}
+	if raw := req.GetPriceLessThan(); raw != "" {
+		if v, err := decimal.NewFromString(raw); err == nil {
+			f, _ := v.Float64()
+			filters.PriceLessThan = &f
+		}
+	}
+
+	products, total, err := s.repo.GetFilteredProducts(ctx, int(req.GetOffset()), int(req.GetLimit()), filters)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &catalogpb.ListProductsResponse{
+		Products: toProtoProducts(products),
+		Total:    total,
+	}, nil
+}
+
+func (s *catalogServer) GetProduct(ctx context.Context, req *catalogpb.GetProductRequest) (*catalogpb.Product, error) {
+	product, err := s.repo.GetByCode(ctx, req.GetCode())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoProduct(product), nil
+}
+
+func toProtoProducts(products []models.Product) []*catalogpb.Product {
+	out := make([]*catalogpb.Product, len(products))
+	for i := range products {
+		out[i] = toProtoProduct(&products[i])
+	}
+	return out
+}
+
+func toProtoProduct(p *models.Product) *catalogpb.Product {
+	variants := make([]*catalogpb.Variant, len(p.Variants))
+	for i, v := range p.Variants {
+		price := v.Price
+		if price.IsZero() {
+			price = p.Price
+		}
+		variants[i] = &catalogpb.Variant{
+			Name:  v.Name,
+			Sku:   v.SKU,
+			Price: price.String(),
+		}
+	}
+
+	return &catalogpb.Product{
+		Code:  p.Code,
+		Price: p.Price.String(),
+		Category: &catalogpb.Category{
+			Code: p.Category.Code,
+			Name: p.Category.Name,
+		},
+		Variants: variants,
+	}
+}
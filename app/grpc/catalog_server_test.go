@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	catalogpb "github.com/mytheresa/go-hiring-challenge/proto/catalog"
+)
+
+// stubProductRepo is a minimal catalog.ProductProvider used to assert that
+// the gRPC and HTTP transports render the same underlying data identically.
+type stubProductRepo struct {
+	products []models.Product
+}
+
+func (s *stubProductRepo) GetAllProducts(ctx context.Context) ([]models.Product, error) {
+	return s.products, nil
+}
+
+func (s *stubProductRepo) GetFilteredProducts(ctx context.Context, offset, limit int, filters models.ProductFilters) ([]models.Product, int64, error) {
+	return s.products, int64(len(s.products)), nil
+}
+
+func (s *stubProductRepo) GetProductsAfterCursor(ctx context.Context, lastID uint, limit int, filters models.ProductFilters) ([]models.Product, bool, error) {
+	return s.products, false, nil
+}
+
+func (s *stubProductRepo) GetByCode(ctx context.Context, code string) (*models.Product, error) {
+	for _, p := range s.products {
+		if p.Code == code {
+			product := p
+			return &product, nil
+		}
+	}
+	return nil, models.ErrProductNotFound
+}
+
+func TestListProductsMatchesHTTP(t *testing.T) {
+	repo := &stubProductRepo{products: []models.Product{
+		{
+			Code:     "PROD001",
+			Price:    decimal.NewFromFloat(19.99),
+			Category: models.Category{Code: "shoes", Name: "Shoes"},
+		},
+	}}
+
+	httpHandler := catalog.NewCatalogHandler(repo)
+	req := httptest.NewRequest("GET", "/catalog", nil)
+	rec := httptest.NewRecorder()
+	httpHandler.HandleGet(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var httpResp catalog.Response
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&httpResp))
+
+	grpcServer := NewCatalogServer(repo)
+	grpcResp, err := grpcServer.ListProducts(context.Background(), &catalogpb.ListProductsRequest{Limit: 10})
+	assert.NoError(t, err)
+
+	assert.Len(t, grpcResp.Products, len(httpResp.Products))
+	assert.Equal(t, httpResp.Products[0].Code, grpcResp.Products[0].Code)
+	assert.Equal(t, httpResp.Products[0].Category.Code, grpcResp.Products[0].Category.Code)
+	assert.Equal(t, "19.99", grpcResp.Products[0].Price)
+}
+
+func TestGetProductNotFoundMapsToNotFound(t *testing.T) {
+	repo := &stubProductRepo{}
+	grpcServer := NewCatalogServer(repo)
+
+	_, err := grpcServer.GetProduct(context.Background(), &catalogpb.GetProductRequest{Code: "missing"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
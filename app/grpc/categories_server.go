@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mytheresa/go-hiring-challenge/app/categories"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	categoriespb "github.com/mytheresa/go-hiring-challenge/proto/categories"
+)
+
+// categoriesServer adapts categories.CategoryProvider to the generated gRPC
+// service interface, so HTTP and gRPC share one source of truth for
+// category reads and writes.
+type categoriesServer struct {
+	categoriespb.UnimplementedCategoryServiceServer
+	repo categories.CategoryProvider
+}
+
+func NewCategoryServer(repo categories.CategoryProvider) categoriespb.CategoryServiceServer {
+	return &categoriesServer{repo: repo}
+}
+
+func (s *categoriesServer) ListCategories(ctx context.Context, req *categoriespb.ListCategoriesRequest) (*categoriespb.ListCategoriesResponse, error) {
+	cats, err := s.repo.GetAllCategories(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	out := make([]*categoriespb.Category, len(cats))
+	for i, c := range cats {
+		out[i] = &categoriespb.Category{Code: c.Code, Name: c.Name}
+	}
+
+	return &categoriespb.ListCategoriesResponse{Categories: out}, nil
+}
+
+func (s *categoriesServer) CreateCategory(ctx context.Context, req *categoriespb.CreateCategoryRequest) (*categoriespb.Category, error) {
+	category := &models.Category{Code: req.GetCode(), Name: req.GetName()}
+
+	if parentCode := req.GetParentCode(); parentCode != "" {
+		parent, err := s.repo.GetCategoryByCode(ctx, parentCode)
+		if err != nil {
+			return nil, mapError(err)
+		}
+		category.ParentID = &parent.ID
+	}
+
+	if err := s.repo.CreateCategory(ctx, category); err != nil {
+		return nil, mapError(err)
+	}
+	return &categoriespb.Category{Code: category.Code, Name: category.Name}, nil
+}
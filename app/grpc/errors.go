@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+)
+
+// mapError translates the product and category not-found errors into
+// codes.NotFound. Every other error, including invalid-input and duplicate
+// errors that the HTTP handlers render as 400 and 409, becomes codes.Internal.
+func mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, models.ErrProductNotFound) || errors.Is(err, models.ErrCategoryNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func errInternal(r interface{}) error {
+	return status.Error(codes.Internal, fmt.Sprintf("panic: %v", r))
+}
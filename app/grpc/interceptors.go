@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+)
+
+// loggingInterceptor logs the outcome of every unary RPC: the full method
+// name, and the error when the call fails.
+func loggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		log.Printf("grpc: %s failed: %v", info.FullMethod, err)
+	} else {
+		log.Printf("grpc: %s ok", info.FullMethod)
+	}
+	return resp, err
+}
+
+// recoveryInterceptor turns a panic in a handler into an Internal error
+// instead of taking down the server, matching how the HTTP handlers are
+// expected to never crash the process on bad input.
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("grpc: %s panicked: %v", info.FullMethod, r)
+			err = errInternal(r)
+		}
+	}()
+	return handler(ctx, req)
+}
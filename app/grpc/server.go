@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/app/categories"
+	catalogpb "github.com/mytheresa/go-hiring-challenge/proto/catalog"
+	categoriespb "github.com/mytheresa/go-hiring-challenge/proto/categories"
+)
+
+// NewServer wires the product and category repositories into gRPC services
+// and returns a server ready to be handed to a net.Listener, mirroring the
+// HTTP mux setup for the REST API.
+func NewServer(products catalog.ProductProvider, cats categories.CategoryProvider) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoveryInterceptor, loggingInterceptor),
+	)
+
+	catalogpb.RegisterCatalogServiceServer(srv, NewCatalogServer(products))
+	categoriespb.RegisterCategoryServiceServer(srv, NewCategoryServer(cats))
+
+	return srv
+}
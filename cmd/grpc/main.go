@@ -0,0 +1,39 @@
+// Command grpc starts the gRPC server exposing the catalog and categories
+// services alongside the existing HTTP API.
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	grpcapp "github.com/mytheresa/go-hiring-challenge/app/grpc"
+	"github.com/mytheresa/go-hiring-challenge/models"
+)
+
+func main() {
+	addr := os.Getenv("GRPC_ADDR")
+	if addr == "" {
+		addr = ":9090"
+	}
+
+	db, err := gorm.Open(postgres.Open(os.Getenv("DATABASE_URL")), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", addr, err)
+	}
+
+	srv := grpcapp.NewServer(models.NewProductsRepository(db), models.NewCategoryRepository(db))
+
+	log.Printf("grpc server listening on %s", addr)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("grpc server stopped: %v", err)
+	}
+}
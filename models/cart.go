@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CartItem is a single line in a shopping cart: one product, optionally a
+// specific variant of it, at a given quantity. UnitPrice snapshots the
+// price at the moment the line was added, so a later catalog price change
+// doesn't silently change the total of a cart that already holds the item.
+type CartItem struct {
+	ID          uint      `gorm:"primaryKey"`
+	CartID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cart_items_line"`
+	ProductCode string    `gorm:"not null"`
+	VariantSKU  *string
+	// Key is LineKey() persisted as a non-null column, since VariantSKU is
+	// nullable and a unique index can't rely on it alone: most drivers treat
+	// every NULL as distinct, so it wouldn't stop two concurrent first-adds
+	// of the same un-varianted product from racing into two rows.
+	Key       string          `gorm:"not null;uniqueIndex:idx_cart_items_line"`
+	Quantity  int             `gorm:"not null"`
+	UnitPrice decimal.Decimal `gorm:"type:decimal(10,2);not null"`
+}
+
+func (i *CartItem) TableName() string {
+	return "cart_items"
+}
+
+// LineKey is how a cart item is addressed by callers: the variant SKU when
+// one was selected, otherwise the product code.
+func (i *CartItem) LineKey() string {
+	if i.VariantSKU != nil {
+		return *i.VariantSKU
+	}
+	return i.ProductCode
+}
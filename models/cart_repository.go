@@ -0,0 +1,183 @@
+package models
+
+import (
+	"context"
+	goerrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// ErrCartItemNotFound is returned when a cart line can't be found by its
+// line key (variant SKU, or product code when no variant was selected).
+var ErrCartItemNotFound = errors.NotFound("cart_item", "")
+
+// ProductPriceLookup resolves a product by code so CartRepository can
+// snapshot its (or one of its variants') price when a line is added.
+// *ProductsRepository satisfies this.
+type ProductPriceLookup interface {
+	GetByCode(ctx context.Context, code string) (*Product, error)
+}
+
+type CartRepository struct {
+	db       *gorm.DB
+	products ProductPriceLookup
+}
+
+func NewCartRepository(db *gorm.DB, products ProductPriceLookup) *CartRepository {
+	return &CartRepository{db: db, products: products}
+}
+
+// priceFor resolves the unit price for productCode/variantSKU, applying the
+// same variant-inherits-product fallback the catalog handler uses for a
+// zero-priced variant.
+func (r *CartRepository) priceFor(ctx context.Context, productCode string, variantSKU *string) (decimal.Decimal, error) {
+	product, err := r.products.GetByCode(ctx, productCode)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	if variantSKU == nil {
+		return product.Price, nil
+	}
+
+	for _, v := range product.Variants {
+		if v.SKU != *variantSKU {
+			continue
+		}
+		if v.Price.IsZero() {
+			return product.Price, nil
+		}
+		return v.Price, nil
+	}
+	return decimal.Decimal{}, errors.NotFound("variant", *variantSKU)
+}
+
+func (r *CartRepository) findLine(tx *gorm.DB, cartID uuid.UUID, lineKey string) (*CartItem, error) {
+	var item CartItem
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("cart_id = ? AND (variant_sku = ? OR (variant_sku IS NULL AND product_code = ?))", cartID, lineKey, lineKey).
+		First(&item).Error
+	if goerrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCartItemNotFound
+	}
+	if err != nil {
+		return nil, errors.Internal(err)
+	}
+	return &item, nil
+}
+
+// AddItem adds quantity units of productCode/variantSKU to cartID. If the
+// cart already has a line for that product/variant it increments the
+// existing line's quantity instead of creating a duplicate one; the unit
+// price snapshot is only taken the first time a line is created.
+//
+// The increment is done as a single INSERT ... ON CONFLICT DO UPDATE against
+// the unique index on (cart_id, key), rather than a SELECT followed by an
+// INSERT/UPDATE branch: two concurrent first-adds of the same line can't
+// both observe "not found" and create duplicate rows, because the database
+// itself serializes the conflicting inserts and merges the second into the
+// first.
+func (r *CartRepository) AddItem(ctx context.Context, cartID uuid.UUID, productCode string, variantSKU *string, quantity int) (*CartItem, error) {
+	if quantity <= 0 {
+		return nil, errors.Invalid("quantity", quantity)
+	}
+
+	lineKey := productCode
+	if variantSKU != nil {
+		lineKey = *variantSKU
+	}
+
+	price, err := r.priceFor(ctx, productCode, variantSKU)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *CartItem
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		candidate := &CartItem{
+			CartID:      cartID,
+			ProductCode: productCode,
+			VariantSKU:  variantSKU,
+			Key:         lineKey,
+			Quantity:    quantity,
+			UnitPrice:   price,
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "cart_id"}, {Name: "key"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"quantity": gorm.Expr("cart_items.quantity + ?", quantity)}),
+		}).Create(candidate).Error
+		if err != nil {
+			return errors.Internal(err)
+		}
+
+		found, err := r.findLine(tx, cartID, lineKey)
+		if err != nil {
+			return err
+		}
+		item = found
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateQuantity sets the line identified by lineKey in cartID to quantity,
+// deleting it if quantity is 0. Runs in a transaction with a row lock so a
+// concurrent update to the same line can't lose a quantity change.
+func (r *CartRepository) UpdateQuantity(ctx context.Context, cartID uuid.UUID, lineKey string, quantity int) error {
+	if quantity < 0 {
+		return errors.Invalid("quantity", quantity)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		item, err := r.findLine(tx, cartID, lineKey)
+		if err != nil {
+			return err
+		}
+
+		if quantity == 0 {
+			if err := tx.Delete(item).Error; err != nil {
+				return errors.Internal(err)
+			}
+			return nil
+		}
+
+		item.Quantity = quantity
+		if err := tx.Save(item).Error; err != nil {
+			return errors.Internal(err)
+		}
+		return nil
+	})
+}
+
+// RemoveItem deletes the line identified by lineKey from cartID.
+func (r *CartRepository) RemoveItem(ctx context.Context, cartID uuid.UUID, lineKey string) error {
+	res := r.db.WithContext(ctx).
+		Where("cart_id = ? AND (variant_sku = ? OR (variant_sku IS NULL AND product_code = ?))", cartID, lineKey, lineKey).
+		Delete(&CartItem{})
+	if res.Error != nil {
+		return errors.Internal(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrCartItemNotFound
+	}
+	return nil
+}
+
+// GetCart returns every line in cartID, ordered by the order items were
+// added.
+func (r *CartRepository) GetCart(ctx context.Context, cartID uuid.UUID) ([]CartItem, error) {
+	var items []CartItem
+	if err := r.db.WithContext(ctx).Where("cart_id = ?", cartID).Order("id ASC").Find(&items).Error; err != nil {
+		return nil, errors.Internal(err)
+	}
+	return items, nil
+}
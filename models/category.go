@@ -1,13 +1,71 @@
 package models
 
-// Category represents a product category.
-// It includes a unique code and a human-readable name.
+import "gorm.io/gorm"
+
+// Category represents a product category. Categories form a tree via
+// ParentID: a nil ParentID marks a root category.
+//
+// Path is a materialized path (e.g. "/clothing/shirts/") kept in sync by
+// BeforeCreate/BeforeUpdate, so descendant lookups are a single
+// `WHERE path LIKE '/clothing/%'` rather than a recursive query.
 type Category struct {
-	ID   uint   `gorm:"primaryKey"`
-	Code string `gorm:"uniqueIndex;not null"`
-	Name string `gorm:"not null"`
+	ID       uint       `gorm:"primaryKey"`
+	Code     string     `gorm:"uniqueIndex;not null"`
+	Name     string     `gorm:"not null"`
+	ParentID *uint      `gorm:""`
+	Parent   *Category  `gorm:"foreignKey:ParentID"`
+	Children []Category `gorm:"foreignKey:ParentID"`
+	Path     string     `gorm:"index;not null;default:''"`
 }
 
 func (c *Category) TableName() string {
 	return "categories"
 }
+
+// BeforeCreate populates Path from the parent's Path plus this category's
+// own code, so it never needs to be set by callers directly.
+func (c *Category) BeforeCreate(tx *gorm.DB) error {
+	return c.refreshPath(tx)
+}
+
+// BeforeUpdate keeps Path in sync when Code or ParentID changes, and
+// cascades the new prefix to every descendant so their paths stay
+// consistent with this category's new position.
+func (c *Category) BeforeUpdate(tx *gorm.DB) error {
+	var before Category
+	if err := tx.Session(&gorm.Session{}).Unscoped().First(&before, c.ID).Error; err != nil {
+		return err
+	}
+
+	if err := c.refreshPath(tx); err != nil {
+		return err
+	}
+	if before.Path == c.Path {
+		return nil
+	}
+
+	// SkipHooks: the Model here is gorm's zero-value &Category{} used for a
+	// bulk update, not a real row. Without it, this Update would re-trigger
+	// BeforeUpdate against that zero-value model, which would try to look up
+	// category ID 0 and fail the whole statement.
+	return tx.Session(&gorm.Session{SkipHooks: true}).
+		Model(&Category{}).
+		Where("path LIKE ?", before.Path+"%").
+		Where("id <> ?", c.ID).
+		Update("path", gorm.Expr("? || substr(path, ?)", c.Path, len(before.Path)+1)).Error
+}
+
+// refreshPath recomputes c.Path from c.ParentID's current Path.
+func (c *Category) refreshPath(tx *gorm.DB) error {
+	if c.ParentID == nil {
+		c.Path = "/" + c.Code + "/"
+		return nil
+	}
+
+	var parent Category
+	if err := tx.Session(&gorm.Session{}).Select("path").First(&parent, *c.ParentID).Error; err != nil {
+		return err
+	}
+	c.Path = parent.Path + c.Code + "/"
+	return nil
+}
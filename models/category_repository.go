@@ -0,0 +1,125 @@
+package models
+
+import (
+	"context"
+	goerrors "errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
+)
+
+// ErrCategoryNotFound is returned when a category is not found. Compare
+// against it with errors.Is; the underlying *errors.CodedError matches on
+// Reason alone, so it's equal to a NotFound("category", "") minted for any
+// specific code.
+var ErrCategoryNotFound = errors.NotFound("category", "")
+
+type CategoryRepository struct {
+	db *gorm.DB
+
+	listHooks    []ListCategoriesHook
+	listedHooks  []ListedCategoriesHook
+	createHooks  []CreateCategoryHook
+	createdHooks []CategoryCreatedHook
+}
+
+func NewCategoryRepository(db *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{
+		db: db,
+	}
+}
+
+func (r *CategoryRepository) GetAllCategories(ctx context.Context) ([]Category, error) {
+	if err := r.runListHooks(ctx); err != nil {
+		var categories []Category
+		r.runListedHooks(ctx, &categories, &err)
+		return categories, err
+	}
+
+	var categories []Category
+	err := r.db.WithContext(ctx).Find(&categories).Error
+	r.runListedHooks(ctx, &categories, &err)
+	return categories, err
+}
+
+// GetCategoryTree returns every category with its children nested under it,
+// rooted at the categories with no parent. It reuses GetAllCategories (and its
+// hooks) for the underlying fetch, then assembles the tree from the flat
+// list in memory rather than relying on GORM's recursive Preload.
+func (r *CategoryRepository) GetCategoryTree(ctx context.Context) ([]Category, error) {
+	categories, err := r.GetAllCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(categories), nil
+}
+
+func buildCategoryTree(categories []Category) []Category {
+	byID := make(map[uint]Category, len(categories))
+	childrenOf := make(map[uint][]Category)
+	var rootIDs []uint
+	for _, c := range categories {
+		byID[c.ID] = c
+		if c.ParentID == nil {
+			rootIDs = append(rootIDs, c.ID)
+			continue
+		}
+		childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c)
+	}
+
+	var attach func(c Category) Category
+	attach = func(c Category) Category {
+		kids := childrenOf[c.ID]
+		c.Children = make([]Category, len(kids))
+		for i, k := range kids {
+			c.Children[i] = attach(k)
+		}
+		return c
+	}
+
+	roots := make([]Category, len(rootIDs))
+	for i, id := range rootIDs {
+		roots[i] = attach(byID[id])
+	}
+	return roots
+}
+
+// GetCategoryByCode looks up a single category by its code, for resolving a
+// parent_code input into a ParentID before creating a child category.
+func (r *CategoryRepository) GetCategoryByCode(ctx context.Context, code string) (*Category, error) {
+	var category Category
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&category).Error; err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("category", code)
+		}
+		return nil, errors.Internal(err)
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) CreateCategory(ctx context.Context, category *Category) error {
+	if err := r.runCreateHooks(ctx, category); err != nil {
+		r.runCreatedHooks(ctx, category, &err)
+		return err
+	}
+
+	err := r.db.WithContext(ctx).Create(category).Error
+	if isDuplicateKeyErr(err) {
+		err = errors.Duplicate("category", "code")
+	}
+	r.runCreatedHooks(ctx, category, &err)
+	return err
+}
+
+// isDuplicateKeyErr reports whether err looks like a unique constraint
+// violation. Driver error types vary (sqlite, postgres, ...), so this
+// matches on the message rather than a specific driver's error type.
+func isDuplicateKeyErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "unique") || strings.Contains(msg, "duplicate")
+}
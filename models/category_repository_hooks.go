@@ -0,0 +1,71 @@
+package models
+
+import "context"
+
+// ListCategoriesHook runs before GetAllCategories issues its query, with the
+// same short-circuit semantics as ProductsRepository's FindHook.
+type ListCategoriesHook func(ctx context.Context) error
+
+// ListedCategoriesHook runs after GetAllCategories completes, whether it
+// succeeded or was short-circuited by a ListCategoriesHook.
+type ListedCategoriesHook func(ctx context.Context, categories *[]Category, err *error)
+
+// CreateCategoryHook runs before CreateCategory persists a category, with
+// the same short-circuit semantics as ProductsRepository's FindHook.
+type CreateCategoryHook func(ctx context.Context, category *Category) error
+
+// CategoryCreatedHook runs after CreateCategory completes, whether it
+// succeeded or was short-circuited by a CreateCategoryHook.
+type CategoryCreatedHook func(ctx context.Context, category *Category, err *error)
+
+// UseListCategoriesHook registers a ListCategoriesHook with the repository.
+func (r *CategoryRepository) UseListCategoriesHook(hook ListCategoriesHook) {
+	r.listHooks = append(r.listHooks, hook)
+}
+
+// UseListedCategoriesHook registers a ListedCategoriesHook with the
+// repository.
+func (r *CategoryRepository) UseListedCategoriesHook(hook ListedCategoriesHook) {
+	r.listedHooks = append(r.listedHooks, hook)
+}
+
+// UseCreateCategoryHook registers a CreateCategoryHook with the repository.
+func (r *CategoryRepository) UseCreateCategoryHook(hook CreateCategoryHook) {
+	r.createHooks = append(r.createHooks, hook)
+}
+
+// UseCategoryCreatedHook registers a CategoryCreatedHook with the
+// repository.
+func (r *CategoryRepository) UseCategoryCreatedHook(hook CategoryCreatedHook) {
+	r.createdHooks = append(r.createdHooks, hook)
+}
+
+func (r *CategoryRepository) runListHooks(ctx context.Context) error {
+	for _, h := range r.listHooks {
+		if err := h(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *CategoryRepository) runListedHooks(ctx context.Context, categories *[]Category, err *error) {
+	for _, h := range r.listedHooks {
+		h(ctx, categories, err)
+	}
+}
+
+func (r *CategoryRepository) runCreateHooks(ctx context.Context, category *Category) error {
+	for _, h := range r.createHooks {
+		if err := h(ctx, category); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *CategoryRepository) runCreatedHooks(ctx context.Context, category *Category, err *error) {
+	for _, h := range r.createdHooks {
+		h(ctx, category, err)
+	}
+}
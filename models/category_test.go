@@ -0,0 +1,69 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB opens an in-memory sqlite database migrated with the Category
+// schema, so BeforeCreate/BeforeUpdate can be exercised against a real
+// database rather than mocked out. Each test gets its own named in-memory
+// database (still shared-cache, so gorm's connection pool sees one
+// consistent database per test) so state never leaks between tests.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&Category{}))
+	return db
+}
+
+func TestCategoryBeforeCreateSetsPath(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewCategoryRepository(db)
+
+	root := &Category{Code: "clothing", Name: "Clothing"}
+	require.NoError(t, repo.CreateCategory(ctx, root))
+	assert.Equal(t, "/clothing/", root.Path)
+
+	child := &Category{Code: "shirts", Name: "Shirts", ParentID: &root.ID}
+	require.NoError(t, repo.CreateCategory(ctx, child))
+	assert.Equal(t, "/clothing/shirts/", child.Path)
+}
+
+func TestCategoryBeforeUpdateCascadesPathToDescendants(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+	repo := NewCategoryRepository(db)
+
+	clothing := &Category{Code: "clothing", Name: "Clothing"}
+	require.NoError(t, repo.CreateCategory(ctx, clothing))
+
+	shirts := &Category{Code: "shirts", Name: "Shirts", ParentID: &clothing.ID}
+	require.NoError(t, repo.CreateCategory(ctx, shirts))
+
+	tshirts := &Category{Code: "tshirts", Name: "T-Shirts", ParentID: &shirts.ID}
+	require.NoError(t, repo.CreateCategory(ctx, tshirts))
+
+	// Re-parent shirts under a new root; its own path and every descendant's
+	// path should move with it.
+	accessories := &Category{Code: "accessories", Name: "Accessories"}
+	require.NoError(t, repo.CreateCategory(ctx, accessories))
+
+	shirts.ParentID = &accessories.ID
+	require.NoError(t, db.WithContext(ctx).Save(shirts).Error)
+	assert.Equal(t, "/accessories/shirts/", shirts.Path)
+
+	var reloaded Category
+	require.NoError(t, db.WithContext(ctx).First(&reloaded, tshirts.ID).Error)
+	assert.Equal(t, "/accessories/shirts/tshirts/", reloaded.Path,
+		"descendant path should cascade with the reparented ancestor")
+}
@@ -7,14 +7,32 @@ import (
 // Product represents a product in the catalog.
 // It includes a unique code, price, category, and a list of variants.
 type Product struct {
-	ID         uint            `gorm:"primaryKey"`
-	Code       string          `gorm:"uniqueIndex;not null"`
-	Price      decimal.Decimal `gorm:"type:decimal(10,2);not null"`
-	CategoryID uint            `gorm:"not null"`
-	Category   Category        `gorm:"foreignKey:CategoryID"`
-	Variants   []Variant       `gorm:"foreignKey:ProductID"`
+	ID          uint            `gorm:"primaryKey"`
+	Code        string          `gorm:"uniqueIndex;not null"`
+	Name        string          `gorm:""`
+	Description string          `gorm:""`
+	Price       decimal.Decimal `gorm:"type:decimal(10,2);not null"`
+	CategoryID  uint            `gorm:"not null"`
+	Category    Category        `gorm:"foreignKey:CategoryID"`
+	Variants    []Variant       `gorm:"foreignKey:ProductID"`
 }
 
 func (p *Product) TableName() string {
 	return "products"
 }
+
+// Variant is one purchasable variant of a Product, e.g. a specific size or
+// color, identified by its own SKU.
+type Variant struct {
+	ID        uint   `gorm:"primaryKey"`
+	ProductID uint   `gorm:"not null"`
+	Name      string `gorm:""`
+	SKU       string `gorm:"uniqueIndex;not null"`
+	// Price overrides the parent Product's price when non-zero; a zero
+	// Price means the variant is sold at the product's price.
+	Price decimal.Decimal `gorm:"type:decimal(10,2)"`
+}
+
+func (v *Variant) TableName() string {
+	return "variants"
+}
@@ -1,21 +1,73 @@
 package models
 
 import (
-	"errors"
+	"context"
+	goerrors "errors"
+	"strings"
 
 	"gorm.io/gorm"
+
+	"github.com/mytheresa/go-hiring-challenge/pkg/errors"
 )
 
 type ProductsRepository struct {
 	db *gorm.DB
+
+	findHooks      []FindHook
+	foundHooks     []FoundHook
+	getByCodeHooks []GetByCodeHook
+	codeFoundHooks []CodeFoundHook
 }
 
-// ErrProductNotFound is returned when a product is not found.
-var ErrProductNotFound = errors.New("product not found")
+// ErrProductNotFound is returned when a product is not found. Compare
+// against it with errors.Is; the underlying *errors.CodedError matches on
+// Reason alone, so it's equal to a NotFound("product", code) minted for any
+// specific code.
+var ErrProductNotFound = errors.NotFound("product", "")
+
+// SortField identifies a column ProductFilters.Sort can order results by.
+type SortField string
+
+const (
+	SortByPrice SortField = "price"
+	SortByCode  SortField = "code"
+)
+
+// SortKey is one key of a multi-field ORDER BY, e.g. {Field: SortByPrice}
+// for the query param `sort=price`, or {Field: SortByCode, Descending: true}
+// for `sort=-code`.
+type SortKey struct {
+	Field      SortField
+	Descending bool
+}
+
+// column returns the SQL column this key sorts by, or "" if Field isn't
+// recognized.
+func (k SortKey) column() string {
+	switch k.Field {
+	case SortByPrice:
+		return "products.price"
+	case SortByCode:
+		return "products.code"
+	default:
+		return ""
+	}
+}
 
 type ProductFilters struct {
-	CategoryCode  string
-	PriceLessThan *float64
+	CategoryCodes []string
+	// IncludeDescendants expands CategoryCodes to also match every
+	// transitive subcategory, resolved via Category.Path rather than a
+	// recursive query.
+	IncludeDescendants  bool
+	PriceLessThan       *float64
+	PriceLessOrEqual    *float64
+	PriceGreaterThan    *float64
+	PriceGreaterOrEqual *float64
+	// Query matches case-insensitively against a product's name or
+	// description.
+	Query string
+	Sort  []SortKey
 }
 
 func NewProductsRepository(db *gorm.DB) *ProductsRepository {
@@ -25,9 +77,9 @@ func NewProductsRepository(db *gorm.DB) *ProductsRepository {
 }
 
 // Unused method, but keeping for potential future use
-func (r *ProductsRepository) GetAllProducts() ([]Product, error) {
+func (r *ProductsRepository) GetAllProducts(ctx context.Context) ([]Product, error) {
 	var products []Product
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("Variants").
 		Preload("Category").
 		Find(&products).Error; err != nil {
@@ -36,27 +88,85 @@ func (r *ProductsRepository) GetAllProducts() ([]Product, error) {
 	return products, nil
 }
 
-func (r *ProductsRepository) GetFilteredProducts(offset, limit int, filters ProductFilters) ([]Product, int64, error) {
-	var products []Product
-	var total int64
+func (r *ProductsRepository) GetFilteredProducts(ctx context.Context, offset, limit int, filters ProductFilters) ([]Product, int64, error) {
+	if err := r.runFindHooks(ctx, &filters, offset, limit); err != nil {
+		var products []Product
+		var total int64
+		r.runFoundHooks(ctx, &filters, &products, &total, &err)
+		return products, total, err
+	}
 
-	query := r.db.Model(&Product{}).
-		Joins("LEFT JOIN categories ON categories.id = products.category_id").
-		Preload("Category")
+	products, total, err := r.getFilteredProducts(ctx, offset, limit, filters)
+	r.runFoundHooks(ctx, &filters, &products, &total, &err)
+	return products, total, err
+}
 
-	// Filter
-	if filters.CategoryCode != "" {
-		query = query.Where("categories.code = ?", filters.CategoryCode)
+// applyFilters adds the category/price/free-text WHERE clauses shared by
+// getFilteredProducts and GetProductsAfterCursor.
+func applyFilters(query *gorm.DB, filters ProductFilters) *gorm.DB {
+	if len(filters.CategoryCodes) > 0 {
+		if filters.IncludeDescendants {
+			query = query.Where(
+				"EXISTS (SELECT 1 FROM categories anc WHERE anc.code IN ? AND categories.path LIKE anc.path || '%')",
+				filters.CategoryCodes,
+			)
+		} else {
+			query = query.Where("categories.code IN ?", filters.CategoryCodes)
+		}
 	}
 	if filters.PriceLessThan != nil {
 		query = query.Where("products.price < ?", *filters.PriceLessThan)
 	}
+	if filters.PriceLessOrEqual != nil {
+		query = query.Where("products.price <= ?", *filters.PriceLessOrEqual)
+	}
+	if filters.PriceGreaterThan != nil {
+		query = query.Where("products.price > ?", *filters.PriceGreaterThan)
+	}
+	if filters.PriceGreaterOrEqual != nil {
+		query = query.Where("products.price >= ?", *filters.PriceGreaterOrEqual)
+	}
+	if filters.Query != "" {
+		like := "%" + filters.Query + "%"
+		query = query.Where("products.name ILIKE ? OR products.description ILIKE ?", like, like)
+	}
+	return query
+}
+
+// orderBy builds an ORDER BY clause from keys, or "" if none were
+// requested (or none named a recognized field).
+func orderBy(keys []SortKey) string {
+	clauses := make([]string, 0, len(keys))
+	for _, k := range keys {
+		col := k.column()
+		if col == "" {
+			continue
+		}
+		if k.Descending {
+			col += " DESC"
+		}
+		clauses = append(clauses, col)
+	}
+	return strings.Join(clauses, ", ")
+}
+
+func (r *ProductsRepository) getFilteredProducts(ctx context.Context, offset, limit int, filters ProductFilters) ([]Product, int64, error) {
+	var products []Product
+	var total int64
+
+	query := applyFilters(r.db.WithContext(ctx).Model(&Product{}).
+		Joins("LEFT JOIN categories ON categories.id = products.category_id").
+		Preload("Category"), filters)
 
 	// Count total after filtering
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
+	if sort := orderBy(filters.Sort); sort != "" {
+		query = query.Order(sort)
+	}
+
 	// Apply pagination
 	if err := query.Offset(offset).Limit(limit).Find(&products).Error; err != nil {
 		return nil, 0, err
@@ -65,17 +175,62 @@ func (r *ProductsRepository) GetFilteredProducts(offset, limit int, filters Prod
 	return products, total, nil
 }
 
-func (r *ProductsRepository) GetByCode(code string) (*Product, error) {
+// GetProductsAfterCursor implements keyset pagination: it returns the first
+// limit+1 products with id greater than lastID (ordered by id ascending) so
+// callers can tell whether another page exists without a separate COUNT
+// query. The extra row, if fetched, is trimmed before returning.
+//
+// filters.Sort is intentionally ignored here: keyset continuation relies on
+// a stable id order, so cursor-paginated listings are always returned in id
+// order. The catalog handler rejects sort combined with cursor before
+// calling this, rather than silently ignoring it; this is a defense in
+// depth in case another caller skips that check.
+func (r *ProductsRepository) GetProductsAfterCursor(ctx context.Context, lastID uint, limit int, filters ProductFilters) ([]Product, bool, error) {
+	var products []Product
+
+	query := applyFilters(r.db.WithContext(ctx).Model(&Product{}).
+		Joins("LEFT JOIN categories ON categories.id = products.category_id").
+		Preload("Category"), filters)
+
+	if lastID > 0 {
+		query = query.Where("products.id > ?", lastID)
+	}
+
+	if err := query.Order("products.id ASC").Limit(limit + 1).Find(&products).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasNext := len(products) > limit
+	if hasNext {
+		products = products[:limit]
+	}
+
+	return products, hasNext, nil
+}
+
+func (r *ProductsRepository) GetByCode(ctx context.Context, code string) (*Product, error) {
+	if err := r.runGetByCodeHooks(ctx, &code); err != nil {
+		var product *Product
+		r.runCodeFoundHooks(ctx, &code, &product, &err)
+		return product, err
+	}
+
+	product, err := r.getByCode(ctx, code)
+	r.runCodeFoundHooks(ctx, &code, &product, &err)
+	return product, err
+}
+
+func (r *ProductsRepository) getByCode(ctx context.Context, code string) (*Product, error) {
 	var product Product
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("Variants").
 		Preload("Category").
 		Where("code = ?", code).
 		First(&product).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrProductNotFound
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("product", code)
 		}
-		return nil, err // Other DB error
+		return nil, errors.Internal(err)
 	}
 	return &product, nil
 }
@@ -0,0 +1,72 @@
+package models
+
+import "context"
+
+// FindHook runs before GetFilteredProducts issues its query. Returning a
+// non-nil error short-circuits the call: the DB is never hit, and the
+// registered FoundHooks still run so observers see the failure.
+type FindHook func(ctx context.Context, filters *ProductFilters, offset, limit int) error
+
+// FoundHook runs after GetFilteredProducts completes, whether it succeeded
+// or was short-circuited by a FindHook. It receives pointers so it can
+// rewrite the result slice or the error in place, e.g. to apply soft-delete
+// filtering or translate a driver error into a domain one.
+type FoundHook func(ctx context.Context, filters *ProductFilters, products *[]Product, total *int64, err *error)
+
+// GetByCodeHook runs before GetByCode issues its query, with the same
+// short-circuit semantics as FindHook.
+type GetByCodeHook func(ctx context.Context, code *string) error
+
+// CodeFoundHook runs after GetByCode completes, whether it succeeded or was
+// short-circuited by a GetByCodeHook.
+type CodeFoundHook func(ctx context.Context, code *string, product **Product, err *error)
+
+// UseFindHook registers a FindHook with the repository.
+func (r *ProductsRepository) UseFindHook(hook FindHook) {
+	r.findHooks = append(r.findHooks, hook)
+}
+
+// UseFoundHook registers a FoundHook with the repository.
+func (r *ProductsRepository) UseFoundHook(hook FoundHook) {
+	r.foundHooks = append(r.foundHooks, hook)
+}
+
+// UseGetByCodeHook registers a GetByCodeHook with the repository.
+func (r *ProductsRepository) UseGetByCodeHook(hook GetByCodeHook) {
+	r.getByCodeHooks = append(r.getByCodeHooks, hook)
+}
+
+// UseCodeFoundHook registers a CodeFoundHook with the repository.
+func (r *ProductsRepository) UseCodeFoundHook(hook CodeFoundHook) {
+	r.codeFoundHooks = append(r.codeFoundHooks, hook)
+}
+
+func (r *ProductsRepository) runFindHooks(ctx context.Context, filters *ProductFilters, offset, limit int) error {
+	for _, h := range r.findHooks {
+		if err := h(ctx, filters, offset, limit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ProductsRepository) runFoundHooks(ctx context.Context, filters *ProductFilters, products *[]Product, total *int64, err *error) {
+	for _, h := range r.foundHooks {
+		h(ctx, filters, products, total, err)
+	}
+}
+
+func (r *ProductsRepository) runGetByCodeHooks(ctx context.Context, code *string) error {
+	for _, h := range r.getByCodeHooks {
+		if err := h(ctx, code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ProductsRepository) runCodeFoundHooks(ctx context.Context, code *string, product **Product, err *error) {
+	for _, h := range r.codeFoundHooks {
+		h(ctx, code, product, err)
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductsRepositoryUseFindHookShortCircuits(t *testing.T) {
+	repo := NewProductsRepository(nil)
+
+	wantErr := errors.New("denied")
+	var foundHookErr error
+	var foundHookCalled bool
+
+	repo.UseFindHook(func(ctx context.Context, filters *ProductFilters, offset, limit int) error {
+		return wantErr
+	})
+	repo.UseFoundHook(func(ctx context.Context, filters *ProductFilters, products *[]Product, total *int64, err *error) {
+		foundHookCalled = true
+		foundHookErr = *err
+	})
+
+	products, total, err := repo.GetFilteredProducts(context.Background(), 0, 10, ProductFilters{})
+
+	assert.Equal(t, wantErr, err)
+	assert.Nil(t, products)
+	assert.Zero(t, total)
+	assert.True(t, foundHookCalled, "FoundHook should still run when a FindHook short-circuits")
+	assert.Equal(t, wantErr, foundHookErr)
+}
+
+func TestProductsRepositoryCodeFoundHookCanRewriteError(t *testing.T) {
+	repo := NewProductsRepository(nil)
+
+	repo.UseGetByCodeHook(func(ctx context.Context, code *string) error {
+		return errors.New("boom")
+	})
+	repo.UseCodeFoundHook(func(ctx context.Context, code *string, product **Product, err *error) {
+		*err = ErrProductNotFound
+	})
+
+	_, err := repo.GetByCode(context.Background(), "PROD001")
+
+	assert.ErrorIs(t, err, ErrProductNotFound)
+}
@@ -0,0 +1,102 @@
+// Package errors provides a small "coded error" type shared by the HTTP
+// handlers and repositories: every domain error carries a stable machine
+// readable Reason alongside the HTTP status and a user-safe message, so
+// clients can branch on error.code without parsing prose that's free to
+// change.
+package errors
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CodedError is a domain error with enough structure to render a canonical
+// API error response.
+type CodedError struct {
+	// Code is a stable numeric identifier for the error kind.
+	Code int
+	// Reason is a stable string identifier, e.g. "PRODUCT_NOT_FOUND".
+	Reason string
+	// Status is the HTTP status this error maps to.
+	Status int
+	// Message is safe to show to API clients.
+	Message string
+	// Cause is the underlying error, if any.
+	Cause error
+}
+
+func (e *CodedError) Error() string {
+	return e.Message
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Cause
+}
+
+// Is treats two CodedErrors as equal when they share a Reason, regardless
+// of the identifier or message that produced them. This lets call sites do
+// errors.Is(err, errors.NotFound("product", "")) without caring which
+// product code was missing.
+func (e *CodedError) Is(target error) bool {
+	other, ok := target.(*CodedError)
+	if !ok {
+		return false
+	}
+	return e.Reason == other.Reason
+}
+
+// NotFound builds a 404 CodedError for the given resource and identifier,
+// e.g. NotFound("product", "SKU123").
+func NotFound(resource string, id interface{}) *CodedError {
+	return &CodedError{
+		Code:    http.StatusNotFound,
+		Reason:  fmt.Sprintf("%s_NOT_FOUND", upper(resource)),
+		Status:  http.StatusNotFound,
+		Message: fmt.Sprintf("%s %v not found", resource, id),
+	}
+}
+
+// Invalid builds a 400 CodedError for a rejected input field.
+func Invalid(field string, raw interface{}) *CodedError {
+	return &CodedError{
+		Code:    http.StatusBadRequest,
+		Reason:  fmt.Sprintf("INVALID_%s", upper(field)),
+		Status:  http.StatusBadRequest,
+		Message: fmt.Sprintf("invalid %s: %v", field, raw),
+	}
+}
+
+// Duplicate builds a 409 CodedError for a uniqueness violation on the given
+// resource and field, e.g. Duplicate("category", "code").
+func Duplicate(resource, field string) *CodedError {
+	return &CodedError{
+		Code:    http.StatusConflict,
+		Reason:  fmt.Sprintf("%s_DUPLICATE_%s", upper(resource), upper(field)),
+		Status:  http.StatusConflict,
+		Message: fmt.Sprintf("%s with this %s already exists", resource, field),
+	}
+}
+
+// Internal wraps an unexpected error as a 500 CodedError, keeping the cause
+// available via errors.Unwrap without leaking it into Message.
+func Internal(cause error) *CodedError {
+	return &CodedError{
+		Code:    http.StatusInternalServerError,
+		Reason:  "INTERNAL",
+		Status:  http.StatusInternalServerError,
+		Message: "an internal error occurred",
+		Cause:   cause,
+	}
+}
+
+func upper(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'a' && c <= 'z' {
+			b[i] = c - 'a' + 'A'
+		} else if c == ' ' || c == '-' {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
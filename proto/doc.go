@@ -0,0 +1,6 @@
+// Package proto holds the .proto sources for the gRPC surface and the Go
+// stubs generated from them, one subdirectory per service. Regenerate after
+// editing a .proto file:
+//
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative catalog/catalog.proto categories/categories.proto
+package proto